@@ -0,0 +1,191 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// listSRVRecords queries NIOS directly for every SRV-record sharing
+// dnsView/name. ibclient's object manager only exposes a by-ref lookup
+// (GetSRVRecordByRef) for this record type, so a plain search has to go
+// through IBConnector.GetObject() against the 'record:srv' WAPI object,
+// the same way the typed object-manager methods do internally.
+func listSRVRecords(connector ibclient.IBConnector, dnsView, name string) ([]ibclient.RecordSRV, error) {
+	qp := ibclient.NewQueryParams(false, map[string]string{
+		"name": name,
+		"view": dnsView,
+	})
+
+	var res []ibclient.RecordSRV
+	if err := connector.GetObject(ibclient.NewEmptyRecordSRV(), "", qp, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// computeSRVRecordTTL derives the Terraform 'ttl' value NIOS's UseTtl/Ttl
+// pair maps to: the record's TTL when it overrides the zone default,
+// ttlUndef otherwise.
+func computeSRVRecordTTL(ttl *uint32, useTtl *bool) int {
+	if ttl != nil && useTtl != nil && *useTtl {
+		return int(*ttl)
+	}
+	return ttlUndef
+}
+
+func dataSourceSRVRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSRVRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Combination of service's name, protocol's name and zone's name to look the SRV-record(s) up by.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "NIOS object's reference to look the SRV-record up by.",
+			},
+			"internal_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Internal ID of the SRV-record to look it up by.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of SRV-records matching the given 'dns_view'/'name', 'ref' or 'internal_id'.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_view": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ext_attrs": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSRVRecordRead(d *schema.ResourceData, m interface{}) error {
+	dnsView := d.Get("dns_view").(string)
+	name := d.Get("name").(string)
+	ref := d.Get("ref").(string)
+	internalId := d.Get("internal_id").(string)
+
+	connector := connectorFromMeta(m)
+	objMgr := ibclient.NewObjectManager(connector, "Terraform", "")
+
+	var recs []ibclient.RecordSRV
+	switch {
+	case ref != "":
+		rec, err := objMgr.GetSRVRecordByRef(ref)
+		if err != nil {
+			return fmt.Errorf("failed getting SRV-record by ref '%s': %s", ref, err.Error())
+		}
+		recs = []ibclient.RecordSRV{*rec}
+	case internalId != "":
+		rec, err := searchObjectByRefOrInternalId("SRV", d, m)
+		if err != nil {
+			return fmt.Errorf("failed getting SRV-record by internal ID '%s': %s", internalId, err.Error())
+		}
+		var obj ibclient.RecordSRV
+		recJson, _ := json.Marshal(rec)
+		if err = json.Unmarshal(recJson, &obj); err != nil {
+			return fmt.Errorf("failed parsing SRV-record found by internal ID '%s': %s", internalId, err.Error())
+		}
+		recs = []ibclient.RecordSRV{obj}
+	case name != "":
+		found, err := listSRVRecords(connector, dnsView, name)
+		if err != nil {
+			return fmt.Errorf("failed getting SRV-record(s) named '%s' in view '%s': %s", name, dnsView, err.Error())
+		}
+		recs = found
+	default:
+		return fmt.Errorf("one of 'name', 'ref' or 'internal_id' must be set")
+	}
+
+	results := make([]interface{}, 0, len(recs))
+	for _, rec := range recs {
+		ttl := computeSRVRecordTTL(rec.Ttl, rec.UseTtl)
+
+		eaJSON, err := terraformSerializeEAs(rec.Ea)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, map[string]interface{}{
+			"dns_view":  rec.View,
+			"name":      rec.Name,
+			"priority":  int(rec.Priority),
+			"weight":    int(rec.Weight),
+			"port":      int(rec.Port),
+			"target":    rec.Target,
+			"ttl":       ttl,
+			"comment":   rec.Comment,
+			"ext_attrs": eaJSON,
+			"ref":       rec.Ref,
+		})
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return err
+	}
+
+	if ref != "" {
+		d.SetId(ref)
+	} else if internalId != "" {
+		d.SetId(internalId)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", dnsView, name))
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package infoblox
+
+import "testing"
+
+func TestComputeSRVRecordTTL(t *testing.T) {
+	ttlVal := uint32(3600)
+	trueVal := true
+	falseVal := false
+
+	cases := []struct {
+		name   string
+		ttl    *uint32
+		useTtl *bool
+		want   int
+	}{
+		{"overriding TTL", &ttlVal, &trueVal, 3600},
+		{"zone-default TTL", &ttlVal, &falseVal, ttlUndef},
+		{"nil TTL", nil, &trueVal, ttlUndef},
+		{"nil useTtl", &ttlVal, nil, ttlUndef},
+		{"both nil", nil, nil, ttlUndef},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := computeSRVRecordTTL(c.ttl, c.useTtl); got != c.want {
+				t.Errorf("computeSRVRecordTTL(%v, %v) = %d, want %d", c.ttl, c.useTtl, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package infoblox
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// defaultHTTPTimeout and defaultHTTPPoolConnections size the HTTP
+// transport the WAPI connector runs on, independent of the
+// application-level retry policy configured via providerOperationalSchema().
+const (
+	defaultHTTPTimeout         = 20
+	defaultHTTPPoolConnections = 10
+)
+
+// Provider returns the infoblox Terraform provider, wiring the connection
+// schema and the operational knobs from providerOperationalSchema() into a
+// ConfigureFunc that builds the ibclient.IBConnector shared by every
+// resource/data source via 'meta'.
+func Provider() *schema.Provider {
+	connectionSchema := map[string]*schema.Schema{
+		"server": {
+			Type:        schema.TypeString,
+			Required:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_SERVER", nil),
+			Description: "Infoblox Grid Manager/NIOS appliance to connect to.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Required:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_USERNAME", nil),
+			Description: "Username to authenticate with the Grid Manager.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_PASSWORD", nil),
+			Description: "Password to authenticate with the Grid Manager.",
+		},
+		"wapi_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_WAPI_VERSION", "2.11"),
+			Description: "WAPI version of the Grid Manager.",
+		},
+		"port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_WAPI_PORT", "443"),
+			Description: "Port the Grid Manager's WAPI is listening on.",
+		},
+		"sslmode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_SSL_VERIFY", true),
+			Description: "Whether to verify the Grid Manager's SSL certificate.",
+		},
+	}
+
+	providerSchema := make(map[string]*schema.Schema, len(connectionSchema))
+	for k, v := range connectionSchema {
+		providerSchema[k] = v
+	}
+	for k, v := range providerOperationalSchema() {
+		providerSchema[k] = v
+	}
+
+	return &schema.Provider{
+		Schema: providerSchema,
+
+		ResourcesMap: map[string]*schema.Resource{
+			"infoblox_srv_record":     resourceSRVRecord(),
+			"infoblox_srv_record_set": resourceSRVRecordSet(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"infoblox_srv_record": dataSourceSRVRecord(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	hostConfig := ibclient.HostConfig{
+		Host:    d.Get("server").(string),
+		Version: d.Get("wapi_version").(string),
+		Port:    d.Get("port").(string),
+	}
+	authConfig := ibclient.AuthConfig{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+	transportConfig := ibclient.NewTransportConfig(d.Get("sslmode").(bool), defaultHTTPTimeout, defaultHTTPPoolConnections)
+
+	connector, err := ibclient.NewConnector(
+		hostConfig, authConfig, transportConfig, &ibclient.WapiRequestBuilder{}, &ibclient.WapiHttpRequestor{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating Infoblox WAPI connector: %s", err.Error())
+	}
+
+	return newProviderMeta(d, connector), nil
+}
@@ -0,0 +1,78 @@
+package infoblox
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// providerOperationalSchema returns the provider-block schema fragment for
+// the operational knobs ('max_retries', 'retry_min_delay', 'retry_max_delay',
+// 'read_only') shared by every resource and data source in this package.
+// It is merged into Provider().Schema; newProviderMeta() reads it back off
+// the ResourceData built from that schema once the connector is built.
+func providerOperationalSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"max_retries": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     defaultMaxRetries,
+			Description: "Maximum number of attempts to retry a WAPI call that failed with a transient error (5xx, connection reset, concurrent modification).",
+		},
+		"retry_min_delay": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     int(defaultRetryMinDelay / time.Second),
+			Description: "Minimum delay, in seconds, before the first retry of a failed WAPI call. Doubles on each subsequent attempt, up to 'retry_max_delay'.",
+		},
+		"retry_max_delay": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     int(defaultRetryMaxDelay / time.Second),
+			Description: "Upper bound, in seconds, on the backoff delay between retries of a failed WAPI call.",
+		},
+		"read_only": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When 'true', every resource's Create/Update/Delete skips its mutating WAPI call, logs what would have changed, and only reads the affected record(s)' current state back from NIOS. Use with 'terraform plan -refresh-only' to run the provider as a pure drift detector against Grids where write access is restricted.",
+		},
+	}
+}
+
+// providerMeta is the 'meta' value handed to every resource/data source's
+// CRUD functions. It bundles the operational knobs configured once on the
+// provider block with the WAPI connector built from the connection schema,
+// instead of holding either in a package-level global: a global would be
+// shared (and raced) across multiple aliases of this provider, or multiple
+// provider configurations, in the same process.
+type providerMeta struct {
+	connector ibclient.IBConnector
+	readOnly  bool
+}
+
+// newProviderMeta is called once from the provider's ConfigureFunc, after
+// the raw connector has been built, to capture 'max_retries'/'retry_min_delay'/
+// 'retry_max_delay'/'read_only' off the provider schema defined by
+// providerOperationalSchema() and wrap rawConnector so every
+// CreateObject/GetObject/UpdateObject/DeleteObject call through it is
+// retried per the configured policy.
+func newProviderMeta(d *schema.ResourceData, rawConnector ibclient.IBConnector) *providerMeta {
+	return &providerMeta{
+		connector: newRetryingConnector(rawConnector, retryPolicyFromResourceData(d.Get)),
+		readOnly:  d.Get("read_only").(bool),
+	}
+}
+
+func isReadOnlyMode(m interface{}) bool {
+	return m.(*providerMeta).readOnly
+}
+
+// connectorFromMeta extracts the retry-wrapped WAPI connector from a
+// resource's 'meta' argument. Resources should call this instead of
+// asserting 'meta' directly, so retry behavior applies uniformly without
+// each resource needing its own retry schema fields.
+func connectorFromMeta(m interface{}) ibclient.IBConnector {
+	return m.(*providerMeta).connector
+}
@@ -6,8 +6,14 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"log"
+	"time"
 )
 
+// defaultMinSignatureValidity is the fallback window, in seconds, used to
+// flag an RRSIG as stale when 'min_signature_validity' is left unset.
+const defaultMinSignatureValidity = 86400
+
 func resourceSRVRecord() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSRVRecordCreate,
@@ -78,6 +84,34 @@ func resourceSRVRecord() *schema.Resource {
 				Default:     "",
 				Description: "Extensible attributes of the SRV-record to be added/updated, as a map in JSON format.",
 			},
+			"dnssec_signed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set it to 'true' when the target zone is DNSSEC-signed, so that the provider looks up the RRSIG covering this SRV-record and surfaces its expiration.",
+			},
+			"expected_signer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the key/signer which is expected to have signed this SRV-record's RRset. Ignored when 'dnssec_signed' is 'false'. If the RRSIG found on NIOS was produced by a different signer, apply fails.",
+			},
+			"min_signature_validity": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultMinSignatureValidity,
+				Description: "Minimum remaining validity, in seconds, required of the RRSIG covering this SRV-record. Apply fails if the signature expires sooner than this. Ignored when 'dnssec_signed' is 'false'.",
+			},
+			"rrsig_ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "NIOS object's reference for the RRSIG record covering this SRV-record, populated when 'dnssec_signed' is 'true'.",
+			},
+			"signature_expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration timestamp (RFC3339) of the RRSIG covering this SRV-record, populated when 'dnssec_signed' is 'true'.",
+			},
 			"internal_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +128,58 @@ func resourceSRVRecord() *schema.Resource {
 	}
 }
 
+// checkSRVRecordSignature looks up the RRSIG covering the SRV RRset named
+// by dnsView/name, rejecting the apply if it is missing, signed by an
+// unexpected signer, or due to expire within minValiditySec seconds.
+// On success it returns the RRSIG's ref and expiration so the caller can
+// persist them to the computed 'rrsig_ref' / 'signature_expiration' fields.
+func checkSRVRecordSignature(
+	connector ibclient.IBConnector, dnsView, name, expectedSigner string, minValiditySec int) (string, string, error) {
+
+	rrsig, err := getRRSIGForRRSet(connector, dnsView, name, "SRV")
+	if err != nil {
+		return "", "", fmt.Errorf("error looking up RRSIG for signed SRV-record '%s': %s", name, err.Error())
+	}
+
+	if expectedSigner != "" && rrsig.SignerName != expectedSigner {
+		return "", "", fmt.Errorf(
+			"RRSIG covering SRV-record '%s' was signed by '%s', expected '%s'",
+			name, rrsig.SignerName, expectedSigner)
+	}
+
+	expiration := time.Unix(rrsig.SignatureExpiration, 0)
+
+	if time.Until(expiration) < time.Duration(minValiditySec)*time.Second {
+		return "", "", fmt.Errorf(
+			"RRSIG covering SRV-record '%s' expires at %s, which is within the configured"+
+				" 'min_signature_validity' window of %d seconds",
+			name, expiration.Format(time.RFC3339), minValiditySec)
+	}
+
+	return rrsig.Ref, expiration.Format(time.RFC3339), nil
+}
+
+// findMatchingSRVRecord looks up the SRV-record(s) sharing dnsView/name and
+// returns the one whose priority/weight/port/target match exactly, for use
+// by the 'read_only' drift-detection path where no mutating call is made.
+func findMatchingSRVRecord(
+	connector ibclient.IBConnector, dnsView, name string, priority, weight, port uint32, target string) (*ibclient.RecordSRV, error) {
+
+	candidates, err := listSRVRecords(connector, dnsView, name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range candidates {
+		c := candidates[i]
+		if c.Priority == priority && c.Weight == weight && c.Port == port && c.Target == target {
+			return &c, nil
+		}
+	}
+	return nil, ibclient.NewNotFoundError(fmt.Sprintf(
+		"no SRV-record matching priority=%d weight=%d port=%d target=%s found under '%s' in view '%s'",
+		priority, weight, port, target, name, dnsView))
+}
+
 func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 
 	if intId := d.Get("internal_id"); intId.(string) != "" {
@@ -137,10 +223,48 @@ func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 	if found {
 		tenantID = tempVal.(string)
 	}
-	connector := m.(ibclient.IBConnector)
+	connector := connectorFromMeta(m)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
-	newRecord, err := objMgr.CreateSRVRecord(
+	if isReadOnlyMode(m) {
+		log.Printf("[WARN] read_only mode: would create SRV-record '%s' (priority=%d weight=%d port=%d target=%s);"+
+			" skipping the mutating call and looking up a matching record instead", name, priority, weight, port, target)
+		existing, err := findMatchingSRVRecord(connector, dnsView, name, uint32(priority), uint32(weight), uint32(port), target)
+		if err != nil {
+			return fmt.Errorf("read_only mode prevents creating SRV-record '%s', and none already exists: %s", name, err.Error())
+		}
+		d.SetId(existing.Ref)
+		if err = d.Set("ref", existing.Ref); err != nil {
+			return err
+		}
+		existingInternalId, _ := existing.Ea[eaNameForInternalId].(string)
+		if existingInternalId == "" {
+			log.Printf("[WARN] read_only mode: matched SRV-record '%s' has no '%s' extensible attribute;"+
+				" Terraform will track it under a locally-generated internal_id that NIOS was never updated with,"+
+				" so future internal_id-based lookups will not find it", existing.Ref, eaNameForInternalId)
+			existingInternalId = internalId.String()
+		}
+		if err = d.Set("internal_id", existingInternalId); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	var rrsigRef, expiration string
+	if d.Get("dnssec_signed").(bool) {
+		// Checked before the mutating call: a brand-new record can't have
+		// been covered by a freshly (re)signed RRSIG yet, and failing here
+		// means nothing was ever created in NIOS, unlike checking
+		// afterwards and leaving an orphaned record behind on failure.
+		rrsigRef, expiration, err = checkSRVRecordSignature(
+			connector, dnsView, name, d.Get("expected_signer").(string), d.Get("min_signature_validity").(int))
+		if err != nil {
+			return err
+		}
+	}
+
+	var newRecord *ibclient.RecordSRV
+	newRecord, err = objMgr.CreateSRVRecord(
 		dnsView, name, uint32(priority), uint32(weight), uint32(port), target, ttl, useTtl, comment, extAttrs)
 
 	if err != nil {
@@ -156,6 +280,15 @@ func resourceSRVRecordCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if d.Get("dnssec_signed").(bool) {
+		if err = d.Set("rrsig_ref", rrsigRef); err != nil {
+			return err
+		}
+		if err = d.Set("signature_expiration", expiration); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -315,7 +448,7 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	if found {
 		tenantID = tempVal.(string)
 	}
-	connector := m.(ibclient.IBConnector)
+	connector := connectorFromMeta(m)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	srvrec, err := objMgr.GetSRVRecordByRef(d.Id())
@@ -332,11 +465,56 @@ func resourceSRVRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	newInternalId := newInternalResourceIdFromString(internalId)
 	newExtAttrs[eaNameForInternalId] = newInternalId.String()
 
+	if isReadOnlyMode(m) {
+		log.Printf("[WARN] read_only mode: would update SRV-record '%s' to"+
+			" (priority=%d weight=%d port=%d target=%s); skipping the mutating call and reading current state instead",
+			srvrec.Ref, priority, weight, port, target)
+		updateSuccessful = true
+		if err = d.Set("priority", srvrec.Priority); err != nil {
+			return err
+		}
+		if err = d.Set("weight", srvrec.Weight); err != nil {
+			return err
+		}
+		if err = d.Set("port", srvrec.Port); err != nil {
+			return err
+		}
+		if err = d.Set("target", srvrec.Target); err != nil {
+			return err
+		}
+		if err = d.Set("comment", srvrec.Comment); err != nil {
+			return err
+		}
+		if err = d.Set("internal_id", internalId); err != nil {
+			return err
+		}
+		return nil
+	}
+
 	newExtAttrs, err = mergeEAs(srvrec.Ea, newExtAttrs, oldExtAttrs, connector)
 	if err != nil {
 		return err
 	}
 
+	if d.Get("dnssec_signed").(bool) {
+		// Checked before the mutating call: once UpdateSRVRecord() below
+		// succeeds, NIOS already holds the new values, so a signature
+		// failure discovered afterwards can no longer be used to justify
+		// reverting Terraform's state back to the old ones without leaving
+		// NIOS and state permanently out of sync.
+		rrsigRef, expiration, err := checkSRVRecordSignature(
+			connector, d.Get("dns_view").(string), name, d.Get("expected_signer").(string), d.Get("min_signature_validity").(int))
+		if err != nil {
+			return err
+		}
+		if err = d.Set("rrsig_ref", rrsigRef); err != nil {
+			return err
+		}
+		if err = d.Set("signature_expiration", expiration); err != nil {
+			return err
+		}
+	}
+
 	rec, err := objMgr.UpdateSRVRecord(
 		d.Id(), name, uint32(priority), uint32(weight), uint32(port), target, ttl, useTtl, comment, newExtAttrs)
 	if err != nil {
@@ -367,7 +545,7 @@ func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
 		tenantID = tempVal.(string)
 	}
 
-	connector := m.(ibclient.IBConnector)
+	connector := connectorFromMeta(m)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 	srvrec, err := searchObjectByRefOrInternalId("SRV", d, m)
 	if err != nil {
@@ -389,6 +567,13 @@ func resourceSRVRecordDelete(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("failed getting SRV-Record: %s", err.Error())
 	}
 
+	if isReadOnlyMode(m) {
+		log.Printf("[WARN] read_only mode: would delete SRV-record '%s'; skipping the mutating call"+
+			" and only removing it from Terraform state", obj.Ref)
+		d.SetId("")
+		return nil
+	}
+
 	_, err = objMgr.DeleteSRVRecord(obj.Ref)
 	if err != nil {
 		return fmt.Errorf("deletion of MX-Record failed: %s", err.Error())
@@ -412,7 +597,7 @@ func resourceSRVRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 		tenantID = tempVal.(string)
 	}
 
-	connector := m.(ibclient.IBConnector)
+	connector := connectorFromMeta(m)
 	objMgr := ibclient.NewObjectManager(connector, "Terraform", tenantID)
 
 	obj, err := objMgr.GetSRVRecordByRef(d.Id())
@@ -0,0 +1,561 @@
+package infoblox
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+	"log"
+)
+
+// srvRecordSetEntry is the in-memory shape of one element of the
+// 'record' list, keyed by the tuple NIOS itself uses to distinguish
+// SRV RRset members.
+type srvRecordSetEntry struct {
+	Priority uint32
+	Weight   uint32
+	Port     uint32
+	Target   string
+	Ref      string
+}
+
+func srvRecordSetEntryKey(priority, weight, port uint32, target string) string {
+	return fmt.Sprintf("%d-%d-%d-%s", priority, weight, port, target)
+}
+
+func resourceSRVRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSRVRecordSetCreate,
+		Read:   resourceSRVRecordSetGet,
+		Update: resourceSRVRecordSetUpdate,
+		Delete: resourceSRVRecordSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSRVRecordSetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dns_view": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     defaultDNSView,
+				Description: "DNS view which the zone does exist within",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Combination of service's name, protocol's name and zone's name, shared by every record in the set.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     ttlUndef,
+				Description: "TTL value for every SRV-record in the set.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Description of the SRV-record set",
+			},
+			"ext_attrs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Extensible attributes of the SRV-record set to be added/updated, as a map in JSON format.",
+			},
+			"record": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "One SRV target belonging to this set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Configures the priority (0..65535) for this SRV-record.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Configures weight of the SRV-record, valid values are 0..65535.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Configures port number (0..65535) for this SRV-record.",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Provides service for domain name in the SRV-record.",
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "NIOS object's reference for this entry, not to be set by a user.",
+						},
+					},
+				},
+			},
+			"internal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Internal ID of an object at NIOS side," +
+					" used by Infoblox Terraform plugin to search for a NIOS's object" +
+					" which corresponds to the Terraform resource.",
+			},
+		},
+	}
+}
+
+func expandSRVRecordSetEntries(raw []interface{}) []srvRecordSetEntry {
+	entries := make([]srvRecordSetEntry, 0, len(raw))
+	for _, r := range raw {
+		m := r.(map[string]interface{})
+		entries = append(entries, srvRecordSetEntry{
+			Priority: uint32(m["priority"].(int)),
+			Weight:   uint32(m["weight"].(int)),
+			Port:     uint32(m["port"].(int)),
+			Target:   m["target"].(string),
+			Ref:      m["ref"].(string),
+		})
+	}
+	return entries
+}
+
+func flattenSRVRecordSetEntries(entries []srvRecordSetEntry) []interface{} {
+	raw := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		raw = append(raw, map[string]interface{}{
+			"priority": int(e.Priority),
+			"weight":   int(e.Weight),
+			"port":     int(e.Port),
+			"target":   e.Target,
+			"ref":      e.Ref,
+		})
+	}
+	return raw
+}
+
+func objMgrForSRVRecordSet(d *schema.ResourceData, m interface{}) (ibclient.IBObjectManager, ibclient.IBConnector, map[string]interface{}, error) {
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var tenantID string
+	tempVal, found := extAttrs[eaNameForTenantId]
+	if found {
+		tenantID = tempVal.(string)
+	}
+	connector := connectorFromMeta(m)
+	return ibclient.NewObjectManager(connector, "Terraform", tenantID), connector, extAttrs, nil
+}
+
+func resourceSRVRecordSetCreate(d *schema.ResourceData, m interface{}) error {
+	if intId := d.Get("internal_id"); intId.(string) != "" {
+		return fmt.Errorf("the value of 'internal_id' field must not be set manually")
+	}
+
+	dnsView := d.Get("dns_view").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	objMgr, connector, extAttrs, err := objMgrForSRVRecordSet(d, m)
+	if err != nil {
+		return err
+	}
+
+	internalId := generateInternalId()
+	extAttrs[eaNameForInternalId] = internalId.String()
+
+	entries := expandSRVRecordSetEntries(d.Get("record").([]interface{}))
+	readOnly := isReadOnlyMode(m)
+
+	created := make([]srvRecordSetEntry, 0, len(entries))
+	for i, e := range entries {
+		if readOnly {
+			log.Printf("[WARN] read_only mode: would create SRV-record set entry %d under '%s' (priority=%d weight=%d port=%d target=%s);"+
+				" skipping the mutating call and looking up a matching record instead", i, name, e.Priority, e.Weight, e.Port, e.Target)
+			existing, err := findMatchingSRVRecord(connector, dnsView, name, e.Priority, e.Weight, e.Port, e.Target)
+			if err != nil {
+				return fmt.Errorf("read_only mode prevents creating SRV-record set entry %d (%s:%d), and none already exists: %s",
+					i, e.Target, e.Port, err.Error())
+			}
+			e.Ref = existing.Ref
+			created = append(created, e)
+			continue
+		}
+
+		rec, err := objMgr.CreateSRVRecord(
+			dnsView, name, e.Priority, e.Weight, e.Port, e.Target, ttl, useTtl, comment, extAttrs)
+		if err != nil {
+			// Roll back everything created so far in this apply; a rollback
+			// deletion that itself fails is ignored.
+			for _, c := range created {
+				_, _ = objMgr.DeleteSRVRecord(c.Ref)
+			}
+			return fmt.Errorf("error creating SRV-record set entry %d (%s:%d): %s", i, e.Target, e.Port, err.Error())
+		}
+		e.Ref = rec.Ref
+		created = append(created, e)
+	}
+
+	d.SetId(internalId.String())
+	if err = d.Set("internal_id", internalId.String()); err != nil {
+		return err
+	}
+	if err = d.Set("record", flattenSRVRecordSetEntries(created)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceSRVRecordSetGet(d *schema.ResourceData, m interface{}) error {
+	objMgr, _, _, err := objMgrForSRVRecordSet(d, m)
+	if err != nil {
+		return err
+	}
+
+	extAttrJSON := d.Get("ext_attrs").(string)
+	extAttrs, err := terraformDeserializeEAs(extAttrJSON)
+	if err != nil {
+		return err
+	}
+
+	entries := expandSRVRecordSetEntries(d.Get("record").([]interface{}))
+	refreshed := make([]srvRecordSetEntry, 0, len(entries))
+	var first *ibclient.RecordSRV
+	for _, e := range entries {
+		rec, err := objMgr.GetSRVRecordByRef(e.Ref)
+		if err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); ok {
+				// This entry was removed out-of-band; drop it from state
+				// and let the next plan decide whether to recreate it.
+				continue
+			}
+			return fmt.Errorf("failed getting SRV-record set entry '%s': %s", e.Ref, err.Error())
+		}
+		if first == nil {
+			first = rec
+		}
+		refreshed = append(refreshed, srvRecordSetEntry{
+			Priority: rec.Priority,
+			Weight:   rec.Weight,
+			Port:     rec.Port,
+			Target:   rec.Target,
+			Ref:      rec.Ref,
+		})
+	}
+
+	if len(refreshed) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("record", flattenSRVRecordSetEntries(refreshed)); err != nil {
+		return err
+	}
+
+	// name/ttl/comment/ext_attrs are shared by every entry in the set, so
+	// any one refreshed record reflects the set's current state.
+	if err = d.Set("name", first.Name); err != nil {
+		return err
+	}
+
+	ttl := ttlUndef
+	if first.Ttl != nil && first.UseTtl != nil && *first.UseTtl {
+		ttl = int(*first.Ttl)
+	}
+	if err = d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
+	if err = d.Set("comment", first.Comment); err != nil {
+		return err
+	}
+
+	if internalId, ok := first.Ea[eaNameForInternalId].(string); ok && internalId != "" {
+		if err = d.Set("internal_id", internalId); err != nil {
+			return err
+		}
+	}
+
+	delete(first.Ea, eaNameForInternalId)
+	omittedEAs := omitEAs(first.Ea, extAttrs)
+	if len(omittedEAs) > 0 {
+		eaJSON, err := terraformSerializeEAs(omittedEAs)
+		if err != nil {
+			return err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceSRVRecordSetImport looks up every SRV-record sharing the
+// 'internal_id' extensible attribute passed as the import ID, and
+// populates the set's fields from them, since a record set is tracked by
+// that internal_id rather than by any single record's ref.
+func resourceSRVRecordSetImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	internalId := d.Id()
+	if internalId == "" {
+		return nil, fmt.Errorf("the 'internal_id' extensible attribute value must be provided to import an infoblox_srv_record_set")
+	}
+
+	connector := connectorFromMeta(m)
+	qp := ibclient.NewQueryParams(false, map[string]string{
+		"*" + eaNameForInternalId: internalId,
+	})
+
+	var recs []ibclient.RecordSRV
+	if err := connector.GetObject(ibclient.NewEmptyRecordSRV(), "", qp, &recs); err != nil {
+		return nil, fmt.Errorf("failed getting SRV-record set with internal_id '%s': %s", internalId, err.Error())
+	}
+	if len(recs) == 0 {
+		return nil, fmt.Errorf("no SRV-records found with internal_id '%s'", internalId)
+	}
+
+	entries := make([]srvRecordSetEntry, 0, len(recs))
+	for i := range recs {
+		rec := recs[i]
+		entries = append(entries, srvRecordSetEntry{
+			Priority: rec.Priority,
+			Weight:   rec.Weight,
+			Port:     rec.Port,
+			Target:   rec.Target,
+			Ref:      rec.Ref,
+		})
+	}
+
+	first := recs[0]
+	if err := d.Set("dns_view", first.View); err != nil {
+		return nil, err
+	}
+	if err := d.Set("name", first.Name); err != nil {
+		return nil, err
+	}
+
+	ttl := ttlUndef
+	if first.Ttl != nil && first.UseTtl != nil && *first.UseTtl {
+		ttl = int(*first.Ttl)
+	}
+	if err := d.Set("ttl", ttl); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("comment", first.Comment); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("record", flattenSRVRecordSetEntries(entries)); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("internal_id", internalId); err != nil {
+		return nil, err
+	}
+
+	delete(first.Ea, eaNameForInternalId)
+	if len(first.Ea) > 0 {
+		eaJSON, err := terraformSerializeEAs(first.Ea)
+		if err != nil {
+			return nil, err
+		}
+		if err = d.Set("ext_attrs", eaJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	d.SetId(internalId)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSRVRecordSetUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("internal_id") {
+		return fmt.Errorf("changing the value of 'internal_id' field is not allowed")
+	}
+	if d.HasChange("dns_view") {
+		return fmt.Errorf("changing the value of 'dns_view' field is not allowed")
+	}
+
+	dnsView := d.Get("dns_view").(string)
+	name := d.Get("name").(string)
+	comment := d.Get("comment").(string)
+
+	var ttl uint32
+	useTtl := false
+	tempTTL := d.Get("ttl").(int)
+	if tempTTL >= 0 {
+		useTtl = true
+		ttl = uint32(tempTTL)
+	} else if tempTTL != ttlUndef {
+		return fmt.Errorf("TTL value must be 0 or higher")
+	}
+
+	objMgr, connector, _, err := objMgrForSRVRecordSet(d, m)
+	if err != nil {
+		return err
+	}
+	readOnly := isReadOnlyMode(m)
+
+	oldExtAttrsJSON, newExtAttrsJSON := d.GetChange("ext_attrs")
+	newExtAttrs, err := terraformDeserializeEAs(newExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+	oldExtAttrs, err := terraformDeserializeEAs(oldExtAttrsJSON.(string))
+	if err != nil {
+		return err
+	}
+
+	internalId := d.Get("internal_id").(string)
+	if internalId == "" {
+		internalId = generateInternalId().String()
+	}
+	newExtAttrs[eaNameForInternalId] = internalId
+
+	oldRaw, newRaw := d.GetChange("record")
+	oldEntries := expandSRVRecordSetEntries(oldRaw.([]interface{}))
+	newEntries := expandSRVRecordSetEntries(newRaw.([]interface{}))
+
+	oldByKey := make(map[string]srvRecordSetEntry, len(oldEntries))
+	for _, e := range oldEntries {
+		oldByKey[srvRecordSetEntryKey(e.Priority, e.Weight, e.Port, e.Target)] = e
+	}
+	newByKey := make(map[string]bool, len(newEntries))
+	for _, e := range newEntries {
+		newByKey[srvRecordSetEntryKey(e.Priority, e.Weight, e.Port, e.Target)] = true
+	}
+
+	var currentEAs ibclient.EA
+	if len(oldEntries) > 0 {
+		if existing, err := objMgr.GetSRVRecordByRef(oldEntries[0].Ref); err == nil {
+			currentEAs = existing.Ea
+		}
+	}
+	extAttrs, err := mergeEAs(currentEAs, newExtAttrs, oldExtAttrs, connector)
+	if err != nil {
+		return err
+	}
+
+	// Removals: present in the old set, absent from the new one.
+	for key, old := range oldByKey {
+		if newByKey[key] {
+			continue
+		}
+		if readOnly {
+			log.Printf("[WARN] read_only mode: would remove SRV-record set entry '%s'; skipping the mutating call", old.Ref)
+			continue
+		}
+		if _, err := objMgr.DeleteSRVRecord(old.Ref); err != nil {
+			return fmt.Errorf("error removing SRV-record set entry '%s': %s", old.Ref, err.Error())
+		}
+	}
+
+	// Additions and refreshes: anything in the new set either reuses the
+	// existing ref (name/ttl/comment/ext_attrs changed) or is created fresh.
+	result := make([]srvRecordSetEntry, 0, len(newEntries))
+	created := make([]srvRecordSetEntry, 0)
+	for _, e := range newEntries {
+		key := srvRecordSetEntryKey(e.Priority, e.Weight, e.Port, e.Target)
+		if old, ok := oldByKey[key]; ok {
+			if readOnly {
+				log.Printf("[WARN] read_only mode: would update SRV-record set entry '%s'; skipping the mutating call", old.Ref)
+				e.Ref = old.Ref
+				result = append(result, e)
+				continue
+			}
+			rec, err := objMgr.UpdateSRVRecord(
+				old.Ref, name, e.Priority, e.Weight, e.Port, e.Target, ttl, useTtl, comment, extAttrs)
+			if err != nil {
+				return fmt.Errorf("error updating SRV-record set entry '%s': %s", old.Ref, err.Error())
+			}
+			e.Ref = rec.Ref
+			result = append(result, e)
+			continue
+		}
+
+		if readOnly {
+			log.Printf("[WARN] read_only mode: would add SRV-record set entry (%s:%d); skipping the mutating call"+
+				" and looking up a matching record instead", e.Target, e.Port)
+			existing, err := findMatchingSRVRecord(connector, dnsView, name, e.Priority, e.Weight, e.Port, e.Target)
+			if err != nil {
+				return fmt.Errorf("read_only mode prevents adding SRV-record set entry (%s:%d), and none already exists: %s",
+					e.Target, e.Port, err.Error())
+			}
+			e.Ref = existing.Ref
+			result = append(result, e)
+			continue
+		}
+
+		rec, err := objMgr.CreateSRVRecord(
+			dnsView, name, e.Priority, e.Weight, e.Port, e.Target, ttl, useTtl, comment, extAttrs)
+		if err != nil {
+			// Roll back the entries this Update call itself created;
+			// untouched/removed entries are left as already applied above.
+			for _, c := range created {
+				_, _ = objMgr.DeleteSRVRecord(c.Ref)
+			}
+			return fmt.Errorf("error adding SRV-record set entry (%s:%d): %s", e.Target, e.Port, err.Error())
+		}
+		e.Ref = rec.Ref
+		created = append(created, e)
+		result = append(result, e)
+	}
+
+	if err = d.Set("record", flattenSRVRecordSetEntries(result)); err != nil {
+		return err
+	}
+	if err = d.Set("internal_id", internalId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceSRVRecordSetDelete(d *schema.ResourceData, m interface{}) error {
+	objMgr, _, _, err := objMgrForSRVRecordSet(d, m)
+	if err != nil {
+		return err
+	}
+
+	entries := expandSRVRecordSetEntries(d.Get("record").([]interface{}))
+
+	if isReadOnlyMode(m) {
+		for _, e := range entries {
+			log.Printf("[WARN] read_only mode: would delete SRV-record set entry '%s'; skipping the mutating call"+
+				" and only removing it from Terraform state", e.Ref)
+		}
+		d.SetId("")
+		return nil
+	}
+
+	for _, e := range entries {
+		if _, err := objMgr.DeleteSRVRecord(e.Ref); err != nil {
+			if _, ok := err.(*ibclient.NotFoundError); ok {
+				continue
+			}
+			return fmt.Errorf("deletion of SRV-record set entry '%s' failed: %s", e.Ref, err.Error())
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,39 @@
+package infoblox
+
+import "testing"
+
+func TestSrvRecordSetEntryKey(t *testing.T) {
+	a := srvRecordSetEntryKey(10, 20, 5060, "sipserver.example.com")
+	b := srvRecordSetEntryKey(10, 20, 5060, "sipserver.example.com")
+	if a != b {
+		t.Fatalf("expected identical keys for identical entries, got %q vs %q", a, b)
+	}
+
+	c := srvRecordSetEntryKey(10, 20, 5061, "sipserver.example.com")
+	if a == c {
+		t.Fatalf("expected different keys for entries differing by port, got %q for both", a)
+	}
+}
+
+func TestExpandFlattenSRVRecordSetEntries_RoundTrip(t *testing.T) {
+	entries := []srvRecordSetEntry{
+		{Priority: 10, Weight: 20, Port: 5060, Target: "sip1.example.com", Ref: "record:srv/ref1"},
+		{Priority: 30, Weight: 40, Port: 5061, Target: "sip2.example.com", Ref: "record:srv/ref2"},
+	}
+
+	flattened := flattenSRVRecordSetEntries(entries)
+	if len(flattened) != len(entries) {
+		t.Fatalf("expected %d flattened entries, got %d", len(entries), len(flattened))
+	}
+
+	roundTripped := expandSRVRecordSetEntries(flattened)
+	if len(roundTripped) != len(entries) {
+		t.Fatalf("expected %d round-tripped entries, got %d", len(entries), len(roundTripped))
+	}
+	for i, want := range entries {
+		got := roundTripped[i]
+		if got != want {
+			t.Errorf("entry %d: got %+v, want %+v", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package infoblox
+
+import (
+	"log"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries    = 3
+	defaultRetryMinDelay = 1 * time.Second
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryPolicy controls how withRetry() re-attempts a failed WAPI call.
+type retryPolicy struct {
+	MaxRetries int
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinDelay:   defaultRetryMinDelay,
+		MaxDelay:   defaultRetryMaxDelay,
+	}
+}
+
+// retryPolicyFromResourceData builds a retryPolicy from the provider block's
+// 'max_retries'/'retry_min_delay'/'retry_max_delay' fields, falling back
+// to defaultRetryPolicy() for anything left at its zero value.
+func retryPolicyFromResourceData(get func(string) interface{}) retryPolicy {
+	policy := defaultRetryPolicy()
+	if v, ok := get("max_retries").(int); ok && v > 0 {
+		policy.MaxRetries = v
+	}
+	if v, ok := get("retry_min_delay").(int); ok && v > 0 {
+		policy.MinDelay = time.Duration(v) * time.Second
+	}
+	if v, ok := get("retry_max_delay").(int); ok && v > 0 {
+		policy.MaxDelay = time.Duration(v) * time.Second
+	}
+	return policy
+}
+
+// retryableWAPIErrorPattern matches the WAPI/HTTP status codes and phrases
+// considered transient. ibclient surfaces these as part of the error
+// message rather than through a typed status-code accessor, so
+// isRetryableWAPIError matches on the rendered message instead of
+// asserting a status-code interface. Status codes are wrapped in \b so
+// "500" doesn't match inside an unrelated number like "5000", and phrases
+// like "eof" don't match inside an unrelated word.
+var retryableWAPIErrorPattern = regexp.MustCompile(
+	`\b(500|502|503|504)\b|\b(connection reset|eof|timeout|temporarily unavailable|concurrent modification)\b`)
+
+// isRetryableWAPIError classifies an error returned by a WAPI call: 5xx
+// responses, connection resets/timeouts and "concurrent modification"
+// WAPI errors are transient and worth retrying; 4xx validation errors
+// (bad request, not found, etc.) are not.
+func isRetryableWAPIError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return retryableWAPIErrorPattern.MatchString(strings.ToLower(err.Error()))
+}
+
+// withRetry calls op, retrying with exponential backoff and jitter while
+// isRetryableWAPIError() holds for the returned error, up to
+// policy.MaxRetries additional attempts.
+func withRetry(policy retryPolicy, op func() error) error {
+	err := op()
+	delay := policy.MinDelay
+	for attempt := 1; err != nil && isRetryableWAPIError(err) && attempt <= policy.MaxRetries; attempt++ {
+		wait := delay + time.Duration(rand.Int63n(int64(delay)+1))
+		if wait > policy.MaxDelay {
+			wait = policy.MaxDelay
+		}
+		log.Printf("[WARN] transient error from NIOS, retrying in %s (attempt %d/%d): %s",
+			wait, attempt, policy.MaxRetries, err.Error())
+		time.Sleep(wait)
+
+		delay *= 2
+		if delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+
+		err = op()
+	}
+	return err
+}
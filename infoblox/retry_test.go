@@ -0,0 +1,104 @@
+package infoblox
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableWAPIError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"500 status in message", errors.New("WAPI call failed: 500 Internal Server Error"), true},
+		{"502 status in message", errors.New("502 Bad Gateway"), true},
+		{"503 status in message", errors.New("HTTP error: 503 Service Unavailable"), true},
+		{"504 status in message", errors.New("504 Gateway Timeout"), true},
+		{"connection reset", errors.New("read tcp: connection reset by peer"), true},
+		{"eof", errors.New("unexpected EOF"), true},
+		{"timeout", errors.New("context deadline exceeded: timeout"), true},
+		{"temporarily unavailable", errors.New("service temporarily unavailable"), true},
+		{"concurrent modification", errors.New("Concurrent Modification Error"), true},
+		{"400 bad request", errors.New("400 Bad Request: invalid 'name' field"), false},
+		{"404 not found", errors.New("404 Not Found"), false},
+		{"unrelated error", errors.New("invalid TTL value"), false},
+		{"status code substring inside unrelated number", errors.New("400 Bad Request: 'priority' must be below 5000"), false},
+		{"phrase substring inside unrelated word", errors.New("400 Bad Request: invalid hostname 'iceof.example.com'"), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isRetryableWAPIError(c.err); got != c.want {
+				t.Errorf("isRetryableWAPIError(%v) = %v, want %v", c.err, got, c.want)
+			}
+		})
+	}
+}
+
+func TestWithRetry_SucceedsWithoutRetryOnNilError(t *testing.T) {
+	calls := 0
+	err := withRetry(defaultRetryPolicy(), func() error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected exactly 1 call, got %d", calls)
+	}
+}
+
+func TestWithRetry_RetriesTransientErrorsUntilSuccess(t *testing.T) {
+	policy := retryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	calls := 0
+	err := withRetry(policy, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("503 Service Unavailable")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestWithRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	policy := retryPolicy{MaxRetries: 2, MinDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	calls := 0
+	wantErr := errors.New("503 Service Unavailable")
+	err := withRetry(policy, func() error {
+		calls++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	// The initial attempt plus MaxRetries retries.
+	if calls != policy.MaxRetries+1 {
+		t.Fatalf("expected %d calls, got %d", policy.MaxRetries+1, calls)
+	}
+}
+
+func TestWithRetry_DoesNotRetryNonTransientErrors(t *testing.T) {
+	policy := retryPolicy{MaxRetries: 3, MinDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	calls := 0
+	wantErr := errors.New("400 Bad Request")
+	err := withRetry(policy, func() error {
+		calls++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected exactly 1 call, got %d", calls)
+	}
+}
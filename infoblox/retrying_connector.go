@@ -0,0 +1,56 @@
+package infoblox
+
+import (
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// retryingConnector decorates an ibclient.IBConnector, retrying its
+// mutating/reading calls per policy. Wrapping at the connector layer
+// (rather than at each objMgr.* call site) means every resource built on
+// top of it — SRV or otherwise — gets the same backoff-with-jitter
+// behavior for free through 'meta', without carrying its own retry
+// schema fields.
+type retryingConnector struct {
+	ibclient.IBConnector
+	policy retryPolicy
+}
+
+func newRetryingConnector(inner ibclient.IBConnector, policy retryPolicy) ibclient.IBConnector {
+	return &retryingConnector{IBConnector: inner, policy: policy}
+}
+
+func (c *retryingConnector) CreateObject(obj ibclient.IBObject) (string, error) {
+	var ref string
+	err := withRetry(c.policy, func() error {
+		var opErr error
+		ref, opErr = c.IBConnector.CreateObject(obj)
+		return opErr
+	})
+	return ref, err
+}
+
+func (c *retryingConnector) GetObject(obj ibclient.IBObject, ref string, opts *ibclient.QueryParams, res interface{}) error {
+	return withRetry(c.policy, func() error {
+		return c.IBConnector.GetObject(obj, ref, opts, res)
+	})
+}
+
+func (c *retryingConnector) UpdateObject(obj ibclient.IBObject, ref string) (string, error) {
+	var newRef string
+	err := withRetry(c.policy, func() error {
+		var opErr error
+		newRef, opErr = c.IBConnector.UpdateObject(obj, ref)
+		return opErr
+	})
+	return newRef, err
+}
+
+func (c *retryingConnector) DeleteObject(ref string) (string, error) {
+	var deletedRef string
+	err := withRetry(c.policy, func() error {
+		var opErr error
+		deletedRef, opErr = c.IBConnector.DeleteObject(ref)
+		return opErr
+	})
+	return deletedRef, err
+}
@@ -0,0 +1,53 @@
+package infoblox
+
+import (
+	"fmt"
+	ibclient "github.com/infobloxopen/infoblox-go-client/v2"
+)
+
+// recordRRSIG is a minimal local mapping for the 'record:rrsig' WAPI
+// object. ibclient does not expose a typed wrapper or object-manager
+// method for RRSIG records, so callers query it directly through
+// IBConnector.GetObject() the same way ibclient's own object-manager
+// methods do internally for the record types it does support.
+type recordRRSIG struct {
+	ibclient.IBBase `json:"-"`
+	Ref             string `json:"_ref,omitempty"`
+	Name            string `json:"name,omitempty"`
+	View            string `json:"view,omitempty"`
+	TypeCovered     string `json:"type_covered,omitempty"`
+	SignerName      string `json:"signer_name,omitempty"`
+	// SignatureExpiration is the WAPI 'record:rrsig.signature_expiration'
+	// field, returned by NIOS as a Unix epoch timestamp rather than an
+	// RFC3339 string.
+	SignatureExpiration int64 `json:"signature_expiration,omitempty"`
+}
+
+func newRecordRRSIG() *recordRRSIG {
+	rec := &recordRRSIG{}
+	rec.SetObjectType("record:rrsig")
+	rec.SetReturnFields([]string{"name", "view", "type_covered", "signer_name", "signature_expiration"})
+	return rec
+}
+
+// getRRSIGForRRSet queries NIOS for the RRSIG record covering the RRset
+// named name/dnsView of type rrType (e.g. "SRV"), returning an error if
+// none is found.
+func getRRSIGForRRSet(connector ibclient.IBConnector, dnsView, name, rrType string) (*recordRRSIG, error) {
+	qp := ibclient.NewQueryParams(false, map[string]string{
+		"name":         name,
+		"view":         dnsView,
+		"type_covered": rrType,
+	})
+
+	var res []recordRRSIG
+	if err := connector.GetObject(newRecordRRSIG(), "", qp, &res); err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, ibclient.NewNotFoundError(fmt.Sprintf(
+			"no RRSIG record covering %s RRset '%s' found in view '%s'", rrType, name, dnsView))
+	}
+
+	return &res[0], nil
+}